@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 	"time"
 
 	files "github.com/ipfs/boxo/files"
@@ -79,7 +81,7 @@ func FilePBDataWithStat(data []byte, totalsize uint64, mode os.FileMode, mtime t
 	pbfile.Data = data
 	pbfile.Filesize = proto.Uint64(totalsize)
 
-	pbDataAddStat(pbfile, mode, mtime)
+	pbDataAddStat(pbfile, Stat{Mode: mode, Mtime: mtime})
 
 	data, err := proto.Marshal(pbfile)
 	if err != nil {
@@ -107,7 +109,7 @@ func FolderPBDataWithStat(mode os.FileMode, mtime time.Time) []byte {
 	typ := pb.Data_Directory
 	pbfile.Type = &typ
 
-	pbDataAddStat(pbfile, mode, mtime)
+	pbDataAddStat(pbfile, Stat{Mode: mode, Mtime: mtime})
 
 	data, err := proto.Marshal(pbfile)
 	if err != nil {
@@ -117,19 +119,135 @@ func FolderPBDataWithStat(mode os.FileMode, mtime time.Time) []byte {
 	return data
 }
 
-func pbDataAddStat(data *pb.Data, mode os.FileMode, mtime time.Time) {
-	if mode != 0 {
-		data.Mode = proto.Uint32(files.ModePermsToUnixPerms(mode))
+// FolderPBDataWithXattrs returns bytes that represent a Directory
+// carrying the given mode, mtime and extended attributes.
+func FolderPBDataWithXattrs(mode os.FileMode, mtime time.Time, xattrs map[string][]byte) []byte {
+	pbfile := new(pb.Data)
+	typ := pb.Data_Directory
+	pbfile.Type = &typ
+
+	pbDataAddStat(pbfile, Stat{Mode: mode, Mtime: mtime, Xattrs: xattrs})
+
+	data, err := proto.Marshal(pbfile)
+	if err != nil {
+		panic(err)
 	}
-	if !mtime.IsZero() {
-		data.Mtime = &pb.IPFSTimestamp{
-			Seconds: proto.Int64(mtime.Unix()),
-		}
+	return data
+}
+
+// Stat groups the POSIX metadata that can be attached to an FSNode at
+// creation time. It exists so that the *PBDataWithStat family of
+// constructors doesn't keep growing positional (mode, mtime, ...)
+// parameters every time a new piece of metadata is supported.
+//
+// Uid and Gid are pointers, not plain uint32s: UID/GID 0 is root, a
+// perfectly valid owner, so the zero value can't double as "not part of
+// this Stat". A nil Uid/Gid leaves any existing ownership untouched.
+//
+// TODO: none of this is threaded through `boxo/files` yet (its Node/Stat
+// types have no ownership, xattr, or extra-timestamp fields), nor through
+// the importer/directory/HAMT code paths in `boxo/mfs` and
+// `boxo/ipld/unixfs/io` that build UnixFS nodes from a `boxo/files` tree.
+// This package only carries the UnixFS-level representation; wiring it
+// end to end is tracked as a single follow-up spanning those packages.
+// The same applies to FSNode.MimeType: sniffing content type (e.g. via
+// `net/http.DetectContentType`) and calling SetMimeType from the importer
+// lives in that same follow-up, since the importer isn't part of this
+// package either.
+type Stat struct {
+	Mode   os.FileMode
+	Mtime  time.Time
+	Atime  time.Time
+	Ctime  time.Time
+	Btime  time.Time
+	Uid    *uint32
+	Gid    *uint32
+	User   string
+	Group  string
+	Xattrs map[string][]byte
+}
 
-		if nanos := uint32(mtime.Nanosecond()); nanos > 0 {
-			data.Mtime.Nanos = &nanos
+func pbDataAddStat(data *pb.Data, stat Stat) {
+	if stat.Mode != 0 {
+		data.Mode = proto.Uint32(files.ModePermsToUnixPerms(stat.Mode))
+	}
+	if ts := ipfsTimestamp(stat.Mtime); ts != nil {
+		data.Mtime = ts
+	}
+	if ts := ipfsTimestamp(stat.Atime); ts != nil {
+		data.Atime = ts
+	}
+	if ts := ipfsTimestamp(stat.Ctime); ts != nil {
+		data.Ctime = ts
+	}
+	if ts := ipfsTimestamp(stat.Btime); ts != nil {
+		data.Btime = ts
+	}
+	if stat.Uid != nil {
+		data.Uid = proto.Uint32(*stat.Uid)
+	}
+	if stat.Gid != nil {
+		data.Gid = proto.Uint32(*stat.Gid)
+	}
+	if stat.User != "" {
+		data.User = proto.String(stat.User)
+	}
+	if stat.Group != "" {
+		data.Group = proto.String(stat.Group)
+	}
+	if len(stat.Xattrs) > 0 {
+		data.Xattr = make([]*pb.Xattr, 0, len(stat.Xattrs))
+		for k, v := range stat.Xattrs {
+			data.Xattr = append(data.Xattr, &pb.Xattr{Key: proto.String(k), Value: v})
 		}
+		sortXattrs(data.Xattr)
+	}
+}
+
+func ipfsTimestamp(t time.Time) *pb.IPFSTimestamp {
+	if t.IsZero() {
+		return nil
+	}
+	ts := &pb.IPFSTimestamp{Seconds: proto.Int64(t.Unix())}
+	if nanos := uint32(t.Nanosecond()); nanos > 0 {
+		ts.Nanos = &nanos
+	}
+	return ts
+}
+
+// FilePBDataWithOwner creates a protobuf File with the given byte slice
+// and UID/GID and returns the marshaled protobuf bytes representing it.
+// See the follow-up note on Stat regarding `boxo/files` propagation.
+func FilePBDataWithOwner(data []byte, totalsize uint64, uid, gid uint32) []byte {
+	pbfile := new(pb.Data)
+	typ := pb.Data_File
+	pbfile.Type = &typ
+	pbfile.Data = data
+	pbfile.Filesize = proto.Uint64(totalsize)
+
+	pbDataAddStat(pbfile, Stat{Uid: &uid, Gid: &gid})
+
+	out, err := proto.Marshal(pbfile)
+	if err != nil {
+		panic(err)
 	}
+	return out
+}
+
+// FolderPBDataWithOwner returns bytes that represent a Directory owned
+// by the given UID/GID.
+func FolderPBDataWithOwner(uid, gid uint32) []byte {
+	pbfile := new(pb.Data)
+	typ := pb.Data_Directory
+	pbfile.Type = &typ
+
+	pbDataAddStat(pbfile, Stat{Uid: &uid, Gid: &gid})
+
+	out, err := proto.Marshal(pbfile)
+	if err != nil {
+		panic(err)
+	}
+	return out
 }
 
 // WrapData marshals raw bytes into a `Data_Raw` type protobuf message.
@@ -164,6 +282,24 @@ func SymlinkData(path string) ([]byte, error) {
 	return out, nil
 }
 
+// SymlinkDataWithStat returns a `Data_Symlink` protobuf message for the
+// path you specify, carrying the given mode and mtime.
+func SymlinkDataWithStat(path string, mode os.FileMode, mtime time.Time) ([]byte, error) {
+	pbdata := new(pb.Data)
+	typ := pb.Data_Symlink
+	pbdata.Data = []byte(path)
+	pbdata.Type = &typ
+
+	pbDataAddStat(pbdata, Stat{Mode: mode, Mtime: mtime})
+
+	out, err := proto.Marshal(pbdata)
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
 // HAMTShardData return a `Data_HAMTShard` protobuf message
 func HAMTShardData(data []byte, fanout uint64, hashType uint64) ([]byte, error) {
 	pbdata := new(pb.Data)
@@ -181,6 +317,27 @@ func HAMTShardData(data []byte, fanout uint64, hashType uint64) ([]byte, error)
 	return out, nil
 }
 
+// HAMTShardDataWithStat returns a `Data_HAMTShard` protobuf message
+// carrying the given mode and mtime, inherited from the directory the
+// shard was created from.
+func HAMTShardDataWithStat(data []byte, fanout, hashType uint64, mode os.FileMode, mtime time.Time) ([]byte, error) {
+	pbdata := new(pb.Data)
+	typ := pb.Data_HAMTShard
+	pbdata.Type = &typ
+	pbdata.HashType = proto.Uint64(hashType)
+	pbdata.Data = data
+	pbdata.Fanout = proto.Uint64(fanout)
+
+	pbDataAddStat(pbdata, Stat{Mode: mode, Mtime: mtime})
+
+	out, err := proto.Marshal(pbdata)
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
 // UnwrapData unmarshals a protobuf messages and returns the contents.
 func UnwrapData(data []byte) ([]byte, error) {
 	pbdata := new(pb.Data)
@@ -208,7 +365,7 @@ func size(pbdata *pb.Data) (uint64, error) {
 	switch pbdata.GetType() {
 	case pb.Data_Directory, pb.Data_HAMTShard:
 		return 0, errors.New("can't get data size of directory")
-	case pb.Data_File, pb.Data_Raw:
+	case pb.Data_File, pb.Data_Raw, pb.Data_Metadata:
 		return pbdata.GetFilesize(), nil
 	case pb.Data_Symlink:
 		return uint64(len(pbdata.GetData())), nil
@@ -222,6 +379,10 @@ func size(pbdata *pb.Data) (uint64, error) {
 // The `NewFSNode` constructor should be used instead of just calling `new(FSNode)`
 // to guarantee that the required (`Type` and `Filesize`) fields in the `format`
 // structure are initialized before marshaling (in `GetBytes()`).
+//
+// Deprecated: Prefer `Decode` and the `Node` interface, which expose only
+// the methods meaningful for a given UnixFS type instead of forcing
+// callers to switch on the underlying `pb.Data_DataType`.
 type FSNode struct {
 	// UnixFS format defined as a protocol buffers message.
 	format pb.Data
@@ -300,10 +461,24 @@ func (n *FSNode) RemoveAllBlockSizes() {
 }
 
 // GetBytes marshals this node as a protobuf message.
+//
+// Xattrs are sorted by key first so that the marshaled bytes (and
+// therefore the resulting CID) are stable regardless of the order in
+// which SetXattr was called.
 func (n *FSNode) GetBytes() ([]byte, error) {
+	sortXattrs(n.format.Xattr)
 	return proto.Marshal(&n.format)
 }
 
+// sortXattrs sorts xattrs by key in place for deterministic marshaling.
+func sortXattrs(xattrs []*pb.Xattr) {
+	if len(xattrs) > 1 {
+		sort.Slice(xattrs, func(i, j int) bool {
+			return xattrs[i].GetKey() < xattrs[j].GetKey()
+		})
+	}
+}
+
 // FileSize returns the size of the file.
 func (n *FSNode) FileSize() uint64 {
 	// XXX: This needs to be able to return an error when we don't know the
@@ -406,7 +581,51 @@ func (n *FSNode) SetExtendedMode(mode uint32) {
 
 // ModTime returns the stored last modified timestamp if available.
 func (n *FSNode) ModTime() time.Time {
-	ts := n.format.GetMtime()
+	return fsTimestamp(n.format.GetMtime())
+}
+
+// SetModTime stores the given last modified timestamp, otherwise nullifies stored timestamp.
+func (n *FSNode) SetModTime(ts time.Time) {
+	n.format.Mtime = setFSTimestamp(n.format.Mtime, ts)
+}
+
+// AccessTime returns the stored last accessed timestamp if available.
+// See the follow-up note on Stat regarding `boxo/files` propagation.
+func (n *FSNode) AccessTime() time.Time {
+	return fsTimestamp(n.format.GetAtime())
+}
+
+// SetAccessTime stores the given last accessed timestamp, otherwise
+// nullifies the stored timestamp.
+func (n *FSNode) SetAccessTime(ts time.Time) {
+	n.format.Atime = setFSTimestamp(n.format.Atime, ts)
+}
+
+// ChangeTime returns the stored last inode-changed timestamp if available.
+func (n *FSNode) ChangeTime() time.Time {
+	return fsTimestamp(n.format.GetCtime())
+}
+
+// SetChangeTime stores the given last inode-changed timestamp, otherwise
+// nullifies the stored timestamp.
+func (n *FSNode) SetChangeTime(ts time.Time) {
+	n.format.Ctime = setFSTimestamp(n.format.Ctime, ts)
+}
+
+// BirthTime returns the stored creation timestamp if available.
+func (n *FSNode) BirthTime() time.Time {
+	return fsTimestamp(n.format.GetBtime())
+}
+
+// SetBirthTime stores the given creation timestamp, otherwise nullifies
+// the stored timestamp.
+func (n *FSNode) SetBirthTime(ts time.Time) {
+	n.format.Btime = setFSTimestamp(n.format.Btime, ts)
+}
+
+// fsTimestamp converts a `pb.IPFSTimestamp` into a `time.Time`, rejecting
+// an out-of-range nanosecond component rather than propagating it.
+func fsTimestamp(ts *pb.IPFSTimestamp) time.Time {
 	if ts == nil || ts.Seconds == nil {
 		return time.Time{}
 	}
@@ -416,27 +635,138 @@ func (n *FSNode) ModTime() time.Time {
 	if *ts.Nanos < 1 || *ts.Nanos > 999999999 {
 		return time.Time{}
 	}
-
 	return time.Unix(*ts.Seconds, int64(*ts.Nanos))
 }
 
-// SetModTime stores the given last modified timestamp, otherwise nullifies stored timestamp.
-func (n *FSNode) SetModTime(ts time.Time) {
+// setFSTimestamp returns the `pb.IPFSTimestamp` that should be stored for
+// ts, reusing cur when possible, or nil if ts is the zero time.
+func setFSTimestamp(cur *pb.IPFSTimestamp, ts time.Time) *pb.IPFSTimestamp {
 	if ts.IsZero() {
-		n.format.Mtime = nil
-		return
+		return nil
+	}
+	if cur == nil {
+		cur = &pb.IPFSTimestamp{}
 	}
+	cur.Seconds = proto.Int64(ts.Unix())
+	if ts.Nanosecond() > 0 {
+		cur.Nanos = proto.Uint32(uint32(ts.Nanosecond()))
+	} else {
+		cur.Nanos = nil
+	}
+	return cur
+}
 
-	if n.format.Mtime == nil {
-		n.format.Mtime = &pb.IPFSTimestamp{}
+// Owner returns the optionally stored UID and GID of this node, and
+// whether they were set at all. UID/GID 0 is root, a valid owner in its
+// own right, so it is reported with ok == true rather than being
+// indistinguishable from "unset".
+func (n *FSNode) Owner() (uid, gid uint32, ok bool) {
+	if n.format.Uid == nil && n.format.Gid == nil {
+		return 0, 0, false
 	}
+	return n.format.GetUid(), n.format.GetGid(), true
+}
 
-	n.format.Mtime.Seconds = proto.Int64(ts.Unix())
-	if ts.Nanosecond() > 0 {
-		n.format.Mtime.Nanos = proto.Uint32(uint32(ts.Nanosecond()))
+// SetOwner stores the given UID and GID. A zero UID/GID (root) is stored
+// as-is; use ClearOwner to remove a previously stored owner.
+func (n *FSNode) SetOwner(uid, gid uint32) {
+	n.format.Uid = proto.Uint32(uid)
+	n.format.Gid = proto.Uint32(gid)
+}
+
+// ClearOwner removes any stored UID/GID from this node.
+func (n *FSNode) ClearOwner() {
+	n.format.Uid = nil
+	n.format.Gid = nil
+}
+
+// OwnerNames returns the optionally stored owner user and group names of
+// this node, or empty strings if none were set.
+func (n *FSNode) OwnerNames() (user, group string) {
+	return n.format.GetUser(), n.format.GetGroup()
+}
+
+// SetOwnerNames stores the given owner user and group names. An empty
+// name clears the stored name it replaces.
+func (n *FSNode) SetOwnerNames(user, group string) {
+	if user == "" {
+		n.format.User = nil
 	} else {
-		n.format.Mtime.Nanos = nil
+		n.format.User = proto.String(user)
+	}
+	if group == "" {
+		n.format.Group = nil
+	} else {
+		n.format.Group = proto.String(group)
+	}
+}
+
+// ErrInvalidXattrKey is returned by SetXattr when given an empty key or a
+// key containing a NUL byte.
+var ErrInvalidXattrKey = errors.New("invalid xattr key")
+
+// Xattrs returns the extended attributes stored on this node, keyed by
+// name. It returns nil if none are set.
+func (n *FSNode) Xattrs() map[string][]byte {
+	if len(n.format.Xattr) == 0 {
+		return nil
+	}
+	out := make(map[string][]byte, len(n.format.Xattr))
+	for _, x := range n.format.Xattr {
+		out[x.GetKey()] = x.GetValue()
+	}
+	return out
+}
+
+// SetXattr sets the extended attribute stored under key to value,
+// overwriting any previous value stored under the same key. Ordering is
+// not significant: GetBytes sorts xattrs by key before marshaling.
+func (n *FSNode) SetXattr(key string, value []byte) error {
+	if key == "" || strings.ContainsRune(key, 0) {
+		return ErrInvalidXattrKey
+	}
+	for _, x := range n.format.Xattr {
+		if x.GetKey() == key {
+			x.Value = value
+			return nil
+		}
+	}
+	n.format.Xattr = append(n.format.Xattr, &pb.Xattr{
+		Key:   proto.String(key),
+		Value: value,
+	})
+	return nil
+}
+
+// RemoveXattr removes the extended attribute stored under key, if any.
+func (n *FSNode) RemoveXattr(key string) {
+	for i, x := range n.format.Xattr {
+		if x.GetKey() == key {
+			n.format.Xattr = append(n.format.Xattr[:i], n.format.Xattr[i+1:]...)
+			return
+		}
+	}
+}
+
+// ClearXattrs removes all extended attributes stored on this node.
+func (n *FSNode) ClearXattrs() {
+	n.format.Xattr = nil
+}
+
+// MimeType returns the optionally stored MIME type of this node's
+// content, or the empty string if none was set.
+func (n *FSNode) MimeType() string {
+	return n.format.GetMimeType()
+}
+
+// SetMimeType stores the given MIME type, or clears it if mimeType is
+// empty.
+func (n *FSNode) SetMimeType(mimeType string) {
+	if mimeType == "" {
+		n.format.MimeType = nil
+		return
 	}
+	n.format.MimeType = proto.String(mimeType)
 }
 
 // Metadata is used to store additional FSNode information.
@@ -500,11 +830,46 @@ func EmptyDirNodeWithStat(mode os.FileMode, mtime time.Time) *dag.ProtoNode {
 	return dag.NodeWithData(FolderPBDataWithStat(mode, mtime))
 }
 
+// EmptyDirNodeWithXattrs creates an empty folder Protonode carrying the
+// given mode, mtime and extended attributes.
+func EmptyDirNodeWithXattrs(mode os.FileMode, mtime time.Time, xattrs map[string][]byte) *dag.ProtoNode {
+	return dag.NodeWithData(FolderPBDataWithXattrs(mode, mtime, xattrs))
+}
+
+// EmptyDirNodeWithOwner creates an empty folder Protonode owned by the
+// given UID/GID.
+func EmptyDirNodeWithOwner(uid, gid uint32) *dag.ProtoNode {
+	return dag.NodeWithData(FolderPBDataWithOwner(uid, gid))
+}
+
 // EmptyFileNode creates an empty file Protonode.
 func EmptyFileNode() *dag.ProtoNode {
 	return dag.NodeWithData(FilePBData(nil, 0))
 }
 
+// EmptySymlinkNodeWithStat creates a symlink Protonode pointing at path,
+// carrying the given mode and mtime.
+func EmptySymlinkNodeWithStat(path string, mode os.FileMode, mtime time.Time) (*dag.ProtoNode, error) {
+	data, err := SymlinkDataWithStat(path, mode, mtime)
+	if err != nil {
+		return nil, err
+	}
+	return dag.NodeWithData(data), nil
+}
+
+// EmptyHAMTShardNodeWithStat creates an empty HAMTShard Protonode with the
+// given fanout and hash type, carrying the given mode and mtime so that a
+// shard created from a directory can inherit the directory's stat. See
+// the follow-up note on Stat regarding wiring this into the importer,
+// directory and HAMT code paths in `boxo/mfs`/`boxo/ipld/unixfs/io`.
+func EmptyHAMTShardNodeWithStat(fanout, hashType uint64, mode os.FileMode, mtime time.Time) (*dag.ProtoNode, error) {
+	data, err := HAMTShardDataWithStat(nil, fanout, hashType, mode, mtime)
+	if err != nil {
+		return nil, err
+	}
+	return dag.NodeWithData(data), nil
+}
+
 // ReadUnixFSNodeData extracts the UnixFS data from an IPLD node.
 // Raw nodes are (also) processed because they are used as leaf
 // nodes containing (only) UnixFS data.
@@ -512,21 +877,21 @@ func ReadUnixFSNodeData(node ipld.Node) (data []byte, err error) {
 	switch node := node.(type) {
 
 	case *dag.ProtoNode:
-		fsNode, err := FSNodeFromBytes(node.Data())
+		n, err := Decode(node.Data())
 		if err != nil {
 			return nil, fmt.Errorf("incorrectly formatted protobuf: %s", err)
 		}
 
-		switch fsNode.Type() {
-		case pb.Data_File, pb.Data_Raw:
-			return fsNode.Data(), nil
+		switch n := n.(type) {
+		case *FileNode, *RawNode:
+			return n.FSNode().Data(), nil
 			// Only leaf nodes (of type `Data_Raw`) contain data but due to a
 			// bug the `Data_File` type (normally used for internal nodes) is
 			// also used for leaf nodes, so both types are accepted here
 			// (see the `balanced` package for more details).
 		default:
 			return nil, fmt.Errorf("found %s node in unexpected place",
-				fsNode.Type().String())
+				n.FSNode().Type().String())
 		}
 
 	case *dag.RawNode:
@@ -555,3 +920,199 @@ func ExtractFSNode(node ipld.Node) (*FSNode, error) {
 
 	return fsNode, nil
 }
+
+// MetadataFromNode decodes node itself as a Data_Metadata message,
+// following the older convention (predating FSNode's own MimeType field)
+// of wrapping a file's MIME type in its own node rather than storing it
+// on the file's pb.Data directly. It returns ErrNotProtoNode, or the
+// "incorrect node type" error from MetadataFromBytes, if node isn't
+// actually a Data_Metadata node.
+func MetadataFromNode(node ipld.Node) (*Metadata, error) {
+	protoNode, ok := node.(*dag.ProtoNode)
+	if !ok {
+		return nil, ErrNotProtoNode
+	}
+
+	return MetadataFromBytes(protoNode.Data())
+}
+
+// MimeTypeFromNode returns the MIME type stored for node, if any. It
+// checks FSNode.MimeType() first, then falls back to treating node
+// itself as a Data_Metadata node via MetadataFromNode for callers still
+// using that older, separate-node convention.
+//
+// This package has no access to a node's parent or directory links, so
+// it cannot resolve a "sibling" Data_Metadata node for a File/Raw node
+// passed in on its own; a gateway-style caller wanting that lookup needs
+// to pass in the actual Data_Metadata node itself (e.g. one resolved via
+// the surrounding directory's links), not the file node.
+func MimeTypeFromNode(node ipld.Node) (string, error) {
+	fsNode, err := ExtractFSNode(node)
+	if err != nil {
+		return "", err
+	}
+	if mimeType := fsNode.MimeType(); mimeType != "" {
+		return mimeType, nil
+	}
+
+	md, err := MetadataFromNode(node)
+	if err != nil {
+		return "", nil
+	}
+	return md.MimeType, nil
+}
+
+// Node is a typed view over a UnixFS protobuf message. Unlike FSNode, a
+// concrete Node implementation (FileNode, RawNode, DirNode, HAMTShardNode,
+// SymlinkNode, MetadataNode) exposes only the methods meaningful for its
+// own UnixFS type, so callers no longer need to switch on the underlying
+// `pb.Data_DataType` themselves. Decode is the single entry point for
+// producing a Node from raw bytes.
+type Node interface {
+	// FSNode returns the underlying FSNode, for callers that still need
+	// the untyped API during a migration off it.
+	FSNode() *FSNode
+}
+
+// FileNode is a Node representing a (possibly chunked) regular file.
+type FileNode struct{ n *FSNode }
+
+// FSNode returns the underlying FSNode.
+func (f *FileNode) FSNode() *FSNode { return f.n }
+
+// FileSize returns the size of the file.
+func (f *FileNode) FileSize() uint64 { return f.n.FileSize() }
+
+// NumChildren returns the number of child blocks of this node.
+func (f *FileNode) NumChildren() int { return f.n.NumChildren() }
+
+// BlockSize returns the block size indexed by `i`.
+func (f *FileNode) BlockSize(i int) uint64 { return f.n.BlockSize(i) }
+
+// MimeType returns the optionally stored MIME type of the file's content.
+func (f *FileNode) MimeType() string { return f.n.MimeType() }
+
+// RawNode is a Node representing a raw leaf.
+type RawNode struct{ n *FSNode }
+
+// FSNode returns the underlying FSNode.
+func (r *RawNode) FSNode() *FSNode { return r.n }
+
+// Data returns the raw leaf's content.
+func (r *RawNode) Data() []byte { return r.n.Data() }
+
+// MimeType returns the optionally stored MIME type of the leaf's content.
+func (r *RawNode) MimeType() string { return r.n.MimeType() }
+
+// DirNode is a Node representing a directory.
+//
+// UnixFS stores directory entries as IPLD links on the surrounding
+// ipld.Node rather than inside the protobuf Data payload, so a DirNode
+// produced by Decode (which only sees raw Data bytes) has no entries.
+// DecodeProtoNode also captures the node's links, so Entries() is
+// populated when decoding through it instead.
+type DirNode struct {
+	n     *FSNode
+	links []*ipld.Link
+}
+
+// FSNode returns the underlying FSNode.
+func (d *DirNode) FSNode() *FSNode { return d.n }
+
+// Entries returns this directory's links, or nil if it was produced by
+// Decode rather than DecodeProtoNode.
+func (d *DirNode) Entries() []*ipld.Link { return d.links }
+
+// HAMTShardNode is a Node representing one shard of a HAMT directory.
+type HAMTShardNode struct {
+	n     *FSNode
+	links []*ipld.Link
+}
+
+// FSNode returns the underlying FSNode.
+func (h *HAMTShardNode) FSNode() *FSNode { return h.n }
+
+// Fanout gets the fanout of this shard.
+func (h *HAMTShardNode) Fanout() uint64 { return h.n.Fanout() }
+
+// HashType gets the hash type used to index this shard.
+func (h *HAMTShardNode) HashType() uint64 { return h.n.HashType() }
+
+// Entries returns this shard's links, or nil if it was produced by
+// Decode rather than DecodeProtoNode.
+func (h *HAMTShardNode) Entries() []*ipld.Link { return h.links }
+
+// SymlinkNode is a Node representing a symbolic link.
+type SymlinkNode struct{ n *FSNode }
+
+// FSNode returns the underlying FSNode.
+func (s *SymlinkNode) FSNode() *FSNode { return s.n }
+
+// Target returns the path this symlink points at.
+func (s *SymlinkNode) Target() string { return string(s.n.Data()) }
+
+// MetadataNode is a Node representing a sibling Metadata entry.
+type MetadataNode struct{ n *FSNode }
+
+// FSNode returns the underlying FSNode.
+func (m *MetadataNode) FSNode() *FSNode { return m.n }
+
+// MimeType returns the MIME type stored in this metadata node. Unlike
+// FileNode/RawNode's MimeType(), which read `pb.Data.MimeType` directly,
+// a Data_Metadata node stores it nested inside its Data field as a
+// separately-marshaled pb.Metadata message (see BytesForMetadata), so it
+// must be unmarshaled here rather than read off the FSNode.
+func (m *MetadataNode) MimeType() (string, error) {
+	pbm := new(pb.Metadata)
+	if err := proto.Unmarshal(m.n.Data(), pbm); err != nil {
+		return "", err
+	}
+	return pbm.GetMimeType(), nil
+}
+
+// Size returns the size recorded in this metadata node's sibling Data.
+func (m *MetadataNode) Size() uint64 { return m.n.FileSize() }
+
+// Decode unmarshals b as a UnixFS protobuf message and returns the typed
+// Node matching its type, so callers no longer need to inspect
+// `pb.Data_DataType` themselves.
+//
+// Decode only sees the raw Data bytes, so a DirNode or HAMTShardNode it
+// returns has no Entries(); use DecodeProtoNode when the surrounding
+// ipld.Node (and therefore its links) is available.
+func Decode(b []byte) (Node, error) {
+	fsNode, err := FSNodeFromBytes(b)
+	if err != nil {
+		return nil, err
+	}
+	return nodeFromFSNode(fsNode, nil)
+}
+
+// DecodeProtoNode is like Decode, but also captures node's links so that
+// a resulting DirNode or HAMTShardNode's Entries() is populated.
+func DecodeProtoNode(node *dag.ProtoNode) (Node, error) {
+	fsNode, err := FSNodeFromBytes(node.Data())
+	if err != nil {
+		return nil, err
+	}
+	return nodeFromFSNode(fsNode, node.Links())
+}
+
+func nodeFromFSNode(fsNode *FSNode, links []*ipld.Link) (Node, error) {
+	switch fsNode.Type() {
+	case pb.Data_Raw:
+		return &RawNode{fsNode}, nil
+	case pb.Data_File:
+		return &FileNode{fsNode}, nil
+	case pb.Data_Directory:
+		return &DirNode{fsNode, links}, nil
+	case pb.Data_HAMTShard:
+		return &HAMTShardNode{fsNode, links}, nil
+	case pb.Data_Symlink:
+		return &SymlinkNode{fsNode}, nil
+	case pb.Data_Metadata:
+		return &MetadataNode{fsNode}, nil
+	default:
+		return nil, ErrUnrecognizedType
+	}
+}
@@ -0,0 +1,393 @@
+package unixfs
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	dag "github.com/ipfs/boxo/ipld/merkledag"
+	pb "github.com/ipfs/boxo/ipld/unixfs/pb"
+)
+
+func TestFSNodeOwner(t *testing.T) {
+	n := NewFSNode(pb.Data_File)
+
+	if _, _, ok := n.Owner(); ok {
+		t.Fatal("expected no owner to be set on a fresh FSNode")
+	}
+
+	// UID/GID 0 is root, a valid owner in its own right, and must round
+	// trip as "set" rather than being confused with "unset".
+	n.SetOwner(0, 0)
+	uid, gid, ok := n.Owner()
+	if !ok {
+		t.Fatal("expected owner to be reported as set after SetOwner(0, 0)")
+	}
+	if uid != 0 || gid != 0 {
+		t.Fatalf("expected uid=0 gid=0, got uid=%d gid=%d", uid, gid)
+	}
+
+	n.SetOwner(501, 20)
+	uid, gid, ok = n.Owner()
+	if !ok || uid != 501 || gid != 20 {
+		t.Fatalf("expected uid=501 gid=20 ok=true, got uid=%d gid=%d ok=%v", uid, gid, ok)
+	}
+
+	n.ClearOwner()
+	if _, _, ok := n.Owner(); ok {
+		t.Fatal("expected owner to be cleared after ClearOwner")
+	}
+}
+
+func TestFSNodeOwnerNames(t *testing.T) {
+	n := NewFSNode(pb.Data_File)
+
+	user, group := n.OwnerNames()
+	if user != "" || group != "" {
+		t.Fatalf("expected empty owner names on a fresh FSNode, got %q/%q", user, group)
+	}
+
+	n.SetOwnerNames("alice", "staff")
+	user, group = n.OwnerNames()
+	if user != "alice" || group != "staff" {
+		t.Fatalf("expected alice/staff, got %q/%q", user, group)
+	}
+}
+
+func TestFSNodeExtraTimestamps(t *testing.T) {
+	n := NewFSNode(pb.Data_File)
+
+	if !n.AccessTime().IsZero() || !n.ChangeTime().IsZero() || !n.BirthTime().IsZero() {
+		t.Fatal("expected no atime/ctime/btime to be set on a fresh FSNode")
+	}
+
+	atime := time.Unix(1609459200, 500)
+	ctime := time.Unix(1612137600, 0)
+	btime := time.Unix(1577836800, 250)
+
+	n.SetAccessTime(atime)
+	n.SetChangeTime(ctime)
+	n.SetBirthTime(btime)
+
+	if !n.AccessTime().Equal(atime) {
+		t.Fatalf("expected atime %v, got %v", atime, n.AccessTime())
+	}
+	if !n.ChangeTime().Equal(ctime) {
+		t.Fatalf("expected ctime %v, got %v", ctime, n.ChangeTime())
+	}
+	if !n.BirthTime().Equal(btime) {
+		t.Fatalf("expected btime %v, got %v", btime, n.BirthTime())
+	}
+
+	n.SetAccessTime(time.Time{})
+	if !n.AccessTime().IsZero() {
+		t.Fatal("expected atime to be cleared after SetAccessTime(zero value)")
+	}
+}
+
+func TestFSNodeXattrs(t *testing.T) {
+	n := NewFSNode(pb.Data_File)
+
+	if n.Xattrs() != nil {
+		t.Fatal("expected no xattrs to be set on a fresh FSNode")
+	}
+
+	if err := n.SetXattr("", []byte("x")); err != ErrInvalidXattrKey {
+		t.Fatalf("expected ErrInvalidXattrKey for an empty key, got %v", err)
+	}
+	if err := n.SetXattr("bad\x00key", []byte("x")); err != ErrInvalidXattrKey {
+		t.Fatalf("expected ErrInvalidXattrKey for a key containing NUL, got %v", err)
+	}
+
+	if err := n.SetXattr("user.c", []byte("3")); err != nil {
+		t.Fatalf("SetXattr failed: %v", err)
+	}
+	if err := n.SetXattr("user.a", []byte("1")); err != nil {
+		t.Fatalf("SetXattr failed: %v", err)
+	}
+	if err := n.SetXattr("user.b", []byte("2")); err != nil {
+		t.Fatalf("SetXattr failed: %v", err)
+	}
+
+	// Overwrite an existing key.
+	if err := n.SetXattr("user.a", []byte("one")); err != nil {
+		t.Fatalf("SetXattr overwrite failed: %v", err)
+	}
+
+	got := n.Xattrs()
+	want := map[string][]byte{
+		"user.a": []byte("one"),
+		"user.b": []byte("2"),
+		"user.c": []byte("3"),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d xattrs, got %d", len(want), len(got))
+	}
+	for k, v := range want {
+		if string(got[k]) != string(v) {
+			t.Fatalf("expected xattr %q = %q, got %q", k, v, got[k])
+		}
+	}
+
+	// GetBytes must sort xattrs by key so the marshaled bytes (and thus
+	// the resulting CID) are stable regardless of insertion order.
+	b1, err := n.GetBytes()
+	if err != nil {
+		t.Fatalf("GetBytes failed: %v", err)
+	}
+
+	n2 := NewFSNode(pb.Data_File)
+	n2.SetXattr("user.a", []byte("one"))
+	n2.SetXattr("user.c", []byte("3"))
+	n2.SetXattr("user.b", []byte("2"))
+	b2, err := n2.GetBytes()
+	if err != nil {
+		t.Fatalf("GetBytes failed: %v", err)
+	}
+	if string(b1) != string(b2) {
+		t.Fatal("expected GetBytes to be insertion-order independent once xattrs are sorted")
+	}
+
+	n.RemoveXattr("user.b")
+	if _, ok := n.Xattrs()["user.b"]; ok {
+		t.Fatal("expected user.b to be removed")
+	}
+
+	n.ClearXattrs()
+	if n.Xattrs() != nil {
+		t.Fatal("expected all xattrs to be cleared")
+	}
+}
+
+func TestSymlinkDataWithStat(t *testing.T) {
+	mtime := time.Unix(1700000000, 0)
+	b, err := SymlinkDataWithStat("/target/path", 0644, mtime)
+	if err != nil {
+		t.Fatalf("SymlinkDataWithStat failed: %v", err)
+	}
+
+	n, err := FSNodeFromBytes(b)
+	if err != nil {
+		t.Fatalf("FSNodeFromBytes failed: %v", err)
+	}
+	if n.Type() != pb.Data_Symlink {
+		t.Fatalf("expected a symlink node, got %v", n.Type())
+	}
+	if string(n.Data()) != "/target/path" {
+		t.Fatalf("expected symlink target %q, got %q", "/target/path", n.Data())
+	}
+	if n.Mode() != os.ModeSymlink|0644 {
+		t.Fatalf("expected mode %v, got %v", os.ModeSymlink|0644, n.Mode())
+	}
+	if !n.ModTime().Equal(mtime) {
+		t.Fatalf("expected mtime %v, got %v", mtime, n.ModTime())
+	}
+}
+
+func TestHAMTShardDataWithStat(t *testing.T) {
+	mtime := time.Unix(1700000000, 0)
+	b, err := HAMTShardDataWithStat(nil, 256, 22, 0755, mtime)
+	if err != nil {
+		t.Fatalf("HAMTShardDataWithStat failed: %v", err)
+	}
+
+	n, err := FSNodeFromBytes(b)
+	if err != nil {
+		t.Fatalf("FSNodeFromBytes failed: %v", err)
+	}
+	if n.Type() != pb.Data_HAMTShard {
+		t.Fatalf("expected a HAMTShard node, got %v", n.Type())
+	}
+	if n.Mode() != os.ModeDir|0755 {
+		t.Fatalf("expected mode %v, got %v", os.ModeDir|0755, n.Mode())
+	}
+	if !n.ModTime().Equal(mtime) {
+		t.Fatalf("expected mtime %v, got %v", mtime, n.ModTime())
+	}
+}
+
+func TestEmptyHAMTShardNodeWithStat(t *testing.T) {
+	mtime := time.Unix(1700000000, 0)
+	pn, err := EmptyHAMTShardNodeWithStat(256, 22, 0755, mtime)
+	if err != nil {
+		t.Fatalf("EmptyHAMTShardNodeWithStat failed: %v", err)
+	}
+
+	n, err := FSNodeFromBytes(pn.Data())
+	if err != nil {
+		t.Fatalf("FSNodeFromBytes failed: %v", err)
+	}
+	if n.Type() != pb.Data_HAMTShard {
+		t.Fatalf("expected a HAMTShard node, got %v", n.Type())
+	}
+	if n.Mode() != os.ModeDir|0755 {
+		t.Fatalf("expected mode %v, got %v", os.ModeDir|0755, n.Mode())
+	}
+}
+
+func TestDecode(t *testing.T) {
+	rawBytes := WrapData([]byte("hello"))
+	fileBytes := FilePBData([]byte("hello"), 5)
+	dirBytes := FolderPBData()
+	symlinkBytes, err := SymlinkData("/target")
+	if err != nil {
+		t.Fatalf("SymlinkData failed: %v", err)
+	}
+	hamtBytes, err := HAMTShardData(nil, 256, 22)
+	if err != nil {
+		t.Fatalf("HAMTShardData failed: %v", err)
+	}
+	metadataBytes, err := BytesForMetadata(&Metadata{MimeType: "text/plain"})
+	if err != nil {
+		t.Fatalf("BytesForMetadata failed: %v", err)
+	}
+
+	cases := []struct {
+		name string
+		b    []byte
+		want interface{}
+	}{
+		{"raw", rawBytes, &RawNode{}},
+		{"file", fileBytes, &FileNode{}},
+		{"directory", dirBytes, &DirNode{}},
+		{"symlink", symlinkBytes, &SymlinkNode{}},
+		{"hamtshard", hamtBytes, &HAMTShardNode{}},
+		{"metadata", metadataBytes, &MetadataNode{}},
+	}
+
+	for _, c := range cases {
+		n, err := Decode(c.b)
+		if err != nil {
+			t.Fatalf("%s: Decode failed: %v", c.name, err)
+		}
+		gotType := fmt.Sprintf("%T", n)
+		wantType := fmt.Sprintf("%T", c.want)
+		if gotType != wantType {
+			t.Fatalf("%s: expected Decode to return %s, got %s", c.name, wantType, gotType)
+		}
+	}
+
+	dn, err := Decode(dirBytes)
+	if err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	if entries := dn.(*DirNode).Entries(); entries != nil {
+		t.Fatalf("expected Decode to leave DirNode.Entries() nil, got %v", entries)
+	}
+}
+
+func TestMetadataNode(t *testing.T) {
+	metadataBytes, err := BytesForMetadata(&Metadata{MimeType: "text/plain", Size: 5})
+	if err != nil {
+		t.Fatalf("BytesForMetadata failed: %v", err)
+	}
+
+	n, err := Decode(metadataBytes)
+	if err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	mn, ok := n.(*MetadataNode)
+	if !ok {
+		t.Fatalf("expected a *MetadataNode, got %T", n)
+	}
+
+	mimeType, err := mn.MimeType()
+	if err != nil {
+		t.Fatalf("MimeType failed: %v", err)
+	}
+	if mimeType != "text/plain" {
+		t.Fatalf("expected MIME type %q, got %q", "text/plain", mimeType)
+	}
+	if size := mn.Size(); size != 5 {
+		t.Fatalf("expected size 5, got %d", size)
+	}
+}
+
+func TestDecodeProtoNode(t *testing.T) {
+	pn := dag.NodeWithData(FolderPBData())
+
+	n, err := DecodeProtoNode(pn)
+	if err != nil {
+		t.Fatalf("DecodeProtoNode failed: %v", err)
+	}
+	dirNode, ok := n.(*DirNode)
+	if !ok {
+		t.Fatalf("expected a *DirNode, got %T", n)
+	}
+	if entries := dirNode.Entries(); len(entries) != len(pn.Links()) {
+		t.Fatalf("expected Entries() to mirror the ProtoNode's links, got %v", entries)
+	}
+}
+
+func TestReadUnixFSNodeData(t *testing.T) {
+	pn := dag.NodeWithData(FilePBData([]byte("hello"), 5))
+
+	data, err := ReadUnixFSNodeData(pn)
+	if err != nil {
+		t.Fatalf("ReadUnixFSNodeData failed: %v", err)
+	}
+	if string(data) != "hello" {
+		t.Fatalf("expected %q, got %q", "hello", data)
+	}
+
+	dirPn := dag.NodeWithData(FolderPBData())
+	if _, err := ReadUnixFSNodeData(dirPn); err == nil {
+		t.Fatal("expected ReadUnixFSNodeData to error on a directory node")
+	}
+}
+
+func TestFSNodeMimeType(t *testing.T) {
+	n := NewFSNode(pb.Data_File)
+
+	if n.MimeType() != "" {
+		t.Fatalf("expected no MIME type on a fresh FSNode, got %q", n.MimeType())
+	}
+
+	n.SetMimeType("text/plain")
+	if n.MimeType() != "text/plain" {
+		t.Fatalf("expected text/plain, got %q", n.MimeType())
+	}
+
+	n.SetMimeType("")
+	if n.MimeType() != "" {
+		t.Fatalf("expected MIME type to be cleared, got %q", n.MimeType())
+	}
+}
+
+func TestMimeTypeFromNode(t *testing.T) {
+	n := NewFSNode(pb.Data_File)
+	n.SetMimeType("image/png")
+	b, err := n.GetBytes()
+	if err != nil {
+		t.Fatalf("GetBytes failed: %v", err)
+	}
+	pn := dag.NodeWithData(b)
+
+	mimeType, err := MimeTypeFromNode(pn)
+	if err != nil {
+		t.Fatalf("MimeTypeFromNode failed: %v", err)
+	}
+	if mimeType != "image/png" {
+		t.Fatalf("expected image/png from FSNode.MimeType, got %q", mimeType)
+	}
+
+	// When node itself is a Data_Metadata node (the older, separate-node
+	// convention), MimeTypeFromNode falls back to decoding it as such.
+	// This is not a sibling lookup: the caller must pass the Data_Metadata
+	// node directly, since this package can't resolve one from a File
+	// node's parent/directory links on its own.
+	mdBytes, err := BytesForMetadata(&Metadata{MimeType: "text/plain"})
+	if err != nil {
+		t.Fatalf("BytesForMetadata failed: %v", err)
+	}
+	mdNode := dag.NodeWithData(mdBytes)
+
+	mimeType, err = MimeTypeFromNode(mdNode)
+	if err != nil {
+		t.Fatalf("MimeTypeFromNode failed: %v", err)
+	}
+	if mimeType != "text/plain" {
+		t.Fatalf("expected text/plain from the Metadata fallback, got %q", mimeType)
+	}
+}